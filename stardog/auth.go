@@ -0,0 +1,106 @@
+package stardog
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies a bearer token for request authentication. Token
+// returns the token and its expiration time; a zero expiry means the token
+// does not expire. Implementations are responsible for caching and
+// refreshing as needed — BareDo calls Token before every request.
+type TokenSource interface {
+	Token(ctx context.Context) (token string, expiry time.Time, err error)
+}
+
+// tokenInvalidator is implemented by TokenSources that can discard a cached
+// token so the next Token call fetches a fresh one. BareDo uses this to
+// recover from a 401 caused by a token that expired since it was cached.
+type tokenInvalidator interface {
+	invalidate()
+}
+
+// staticTokenSource always returns the same token.
+type staticTokenSource string
+
+func (s staticTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	return string(s), time.Time{}, nil
+}
+
+// StaticToken returns a TokenSource that always returns token. Use it when
+// the token is managed outside the client, e.g. injected by an operator or
+// obtained from a secrets manager.
+func StaticToken(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+type tokenRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type tokenResponse struct {
+	Token string `json:"token"`
+}
+
+// passwordTokenSource exchanges a username/password for a Stardog JWT via
+// POST admin/tokens, caching it under a mutex until the login response's
+// TokenExpiration passes or invalidate() is called. The client does not
+// populate Response.TokenExpiration, so the expiry is always zero and the
+// cached token is refreshed only after invalidate(), which BareDo calls on
+// a 401.
+type passwordTokenSource struct {
+	client   *Client
+	username string
+	password string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// PasswordToken returns a TokenSource that logs into client's Stardog server
+// with username and password, caching the returned token until a 401
+// response invalidates it.
+func PasswordToken(client *Client, username, password string) TokenSource {
+	return &passwordTokenSource{client: client, username: username, password: password}
+}
+
+func (s *passwordTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Before(s.expiry)) {
+		return s.token, s.expiry, nil
+	}
+
+	// skipTokenAuth keeps this login request from routing back through
+	// Token itself: BareDo would otherwise call Token to set the
+	// Authorization header on the very request that obtains the token,
+	// re-entering this non-reentrant mutex and deadlocking.
+	req, err := s.client.NewRequest("POST", "admin/tokens", tokenRequest{Username: s.username, Password: s.password}, skipTokenAuth())
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return "", time.Time{}, err
+	}
+
+	body := new(tokenResponse)
+	resp, err := s.client.Do(ctx, req, body)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return "", time.Time{}, err
+	}
+
+	s.token = body.Token
+	s.expiry = resp.TokenExpiration.Time
+	s.client.logger.Info("Successfully obtained bearer token", "username", s.username)
+	return s.token, s.expiry, nil
+}
+
+func (s *passwordTokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+	s.expiry = time.Time{}
+}
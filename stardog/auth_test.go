@@ -0,0 +1,130 @@
+package stardog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStaticToken(t *testing.T) {
+	ts := StaticToken("abc123")
+	token, expiry, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if got, want := token, "abc123"; got != want {
+		t.Errorf("token = %q, want %q", got, want)
+	}
+	if !expiry.IsZero() {
+		t.Errorf("expiry = %v, want zero", expiry)
+	}
+}
+
+// TestPasswordTokenSourceLogsIn is a regression test: Token used to deadlock
+// because it took passwordTokenSource's mutex and then, via
+// Client.SetTokenAuth, routed its own login request back through Token to
+// set the Authorization header. If this test does not finish well inside
+// the deadline, the fix has regressed.
+func TestPasswordTokenSourceLogsIn(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/tokens", func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("login request carried an Authorization header: %q", auth)
+		}
+		w.Write([]byte(`{"token":"jwt-1"}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	ts := PasswordToken(c, "alice", "hunter2")
+	c.SetTokenAuth(ts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	token, _, err := ts.Token(ctx)
+	if err != nil {
+		t.Fatalf("Token returned error: %v", err)
+	}
+	if got, want := token, "jwt-1"; got != want {
+		t.Errorf("token = %q, want %q", got, want)
+	}
+
+	// A second call should hit the cache, not the server, since TokenExpiration
+	// was never set.
+	token2, _, err := ts.Token(ctx)
+	if err != nil {
+		t.Fatalf("second Token call returned error: %v", err)
+	}
+	if token2 != token {
+		t.Errorf("cached token = %q, want %q", token2, token)
+	}
+}
+
+// TestBareDoRefusesReauthRetryOfNonRewindableBody is a regression test: a 401
+// triggers a reauth-and-retry in BareDo regardless of any RetryPolicy, so a
+// streaming upload with a token source attached must be refused up front the
+// same way the explicit-retry-policy path already is, rather than resending
+// an already-drained io.Reader on the reauth attempt.
+func TestBareDoRefusesReauthRetryOfNonRewindableBody(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/add", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+	c.SetTokenAuth(StaticToken("abc123"))
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("<urn:a> <urn:b> <urn:c> ."))
+		pw.Close()
+	}()
+
+	req, err := c.NewUploadRequest("POST", "myDb/add", pr, mediaTypeTurtle)
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+
+	_, err = c.BareDo(context.Background(), req)
+	if err != errNonRewindableBody {
+		t.Fatalf("BareDo error = %v, want errNonRewindableBody", err)
+	}
+}
+
+// TestBareDoReauthRetriesRewindableBody confirms the 401 reauth-and-retry
+// path still works for a body that can be rewound.
+func TestBareDoReauthRetriesRewindableBody(t *testing.T) {
+	var calls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/add", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+	c.SetTokenAuth(StaticToken("abc123"))
+
+	req, err := c.NewUploadRequest("POST", "myDb/add", strings.NewReader("<urn:a> <urn:b> <urn:c> ."), mediaTypeTurtle)
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+
+	if _, err := c.BareDo(context.Background(), req); err != nil {
+		t.Fatalf("BareDo returned error: %v", err)
+	}
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("server saw %d calls, want %d", got, want)
+	}
+}
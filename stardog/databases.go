@@ -0,0 +1,274 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+)
+
+// Format identifies an RDF serialization understood by Stardog's data and
+// bulk-load endpoints.
+type Format int
+
+const (
+	FormatTurtle Format = iota
+	FormatNTriples
+	FormatNQuads
+	FormatTriG
+	FormatRDFXML
+	FormatJSONLD
+)
+
+// contentType returns the Content-Type/Accept value Stardog expects for f.
+func (f Format) contentType() string {
+	switch f {
+	case FormatTurtle:
+		return mediaTypeTurtle
+	case FormatNTriples:
+		return mediaTypeNTriples
+	case FormatNQuads:
+		return "application/n-quads"
+	case FormatTriG:
+		return "application/trig"
+	case FormatRDFXML:
+		return "application/rdf+xml"
+	case FormatJSONLD:
+		return mediaTypeJSONLD
+	default:
+		return mediaTypeTurtle
+	}
+}
+
+// DatabasesService handles communication with the database administration
+// and data (add/remove/export) endpoints of the Stardog API.
+type DatabasesService service
+
+type DatabasesList struct {
+	Databases []string `json:"databases,omitempty"`
+}
+
+// NamedFile is an RDF file contributed to DatabasesService.Create, keyed by
+// the name Stardog should bulk-load it under.
+type NamedFile struct {
+	Name   string
+	Reader io.Reader
+}
+
+// List returns the names of the databases Stardog currently hosts.
+func (s *DatabasesService) List(ctx context.Context) (*DatabasesList, *Response, error) {
+	req, err := s.client.NewRequest("GET", "admin/databases", nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	list := new(DatabasesList)
+	resp, err := s.client.Do(ctx, req, list)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+	s.client.logger.Info("Successfully retrieved databases list")
+	return list, resp, nil
+}
+
+// Create creates a new database from config, a JSON document holding the
+// database's admin options (name, index options, and so on), optionally
+// bulk-loading files into it. Stardog requires config and any files to
+// travel together as a single multipart/form-data body. The body is
+// streamed into the request rather than buffered whole in memory, so
+// bulk-loading large graph files at creation time does not require them to
+// fit in memory.
+func (s *DatabasesService) Create(ctx context.Context, config interface{}, files ...NamedFile) (*Response, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		err := func() error {
+			root, err := mw.CreateFormField("root")
+			if err != nil {
+				return err
+			}
+			if err := json.NewEncoder(root).Encode(config); err != nil {
+				return err
+			}
+
+			for _, f := range files {
+				part, err := mw.CreateFormFile(f.Name, f.Name)
+				if err != nil {
+					return err
+				}
+				if _, err := io.Copy(part, f.Reader); err != nil {
+					return err
+				}
+			}
+
+			return mw.Close()
+		}()
+		pw.CloseWithError(err)
+	}()
+
+	req, err := s.client.NewUploadRequest("POST", "admin/databases", pr, mw.FormDataContentType())
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully created database")
+	return resp, nil
+}
+
+// Drop permanently deletes database.
+func (s *DatabasesService) Drop(ctx context.Context, database string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s", url.PathEscape(database))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully dropped database", "database", database)
+	return resp, nil
+}
+
+// Copy copies database to a new database named to. database must be offline.
+func (s *DatabasesService) Copy(ctx context.Context, database, to string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s/copy?to=%s", url.PathEscape(database), url.QueryEscape(to))
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully copied database", "database", database, "to", to)
+	return resp, nil
+}
+
+// Optimize runs Stardog's index optimization process against database.
+func (s *DatabasesService) Optimize(ctx context.Context, database string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s/optimize", url.PathEscape(database))
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully optimized database", "database", database)
+	return resp, nil
+}
+
+// Online brings an offline database back online.
+func (s *DatabasesService) Online(ctx context.Context, database string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s/online", url.PathEscape(database))
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully brought database online", "database", database)
+	return resp, nil
+}
+
+// Offline takes database offline, e.g. prior to Copy.
+func (s *DatabasesService) Offline(ctx context.Context, database string) (*Response, error) {
+	u := fmt.Sprintf("admin/databases/%s/offline", url.PathEscape(database))
+	req, err := s.client.NewRequest("PUT", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully took database offline", "database", database)
+	return resp, nil
+}
+
+// Add streams data, serialized as format, into database. data is sent
+// directly to the request body without buffering, so large graph files do
+// not need to fit in memory.
+func (s *DatabasesService) Add(ctx context.Context, database string, format Format, data io.Reader) (*Response, error) {
+	u := fmt.Sprintf("%s/add", url.PathEscape(database))
+	req, err := s.client.NewUploadRequest("POST", u, data, format.contentType())
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully added data to database", "database", database)
+	return resp, nil
+}
+
+// Remove streams data, serialized as format, and removes any matching
+// statements from database.
+func (s *DatabasesService) Remove(ctx context.Context, database string, format Format, data io.Reader) (*Response, error) {
+	u := fmt.Sprintf("%s/remove", url.PathEscape(database))
+	req, err := s.client.NewUploadRequest("POST", u, data, format.contentType())
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully removed data from database", "database", database)
+	return resp, nil
+}
+
+// Export streams the contents of database to w, serialized as format.
+func (s *DatabasesService) Export(ctx context.Context, database string, format Format, w io.Writer) (*Response, error) {
+	u := fmt.Sprintf("%s/export", url.PathEscape(database))
+	req, err := s.client.NewRequest("GET", u, nil, withAccept(format.contentType()))
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, w)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully exported database", "database", database)
+	return resp, nil
+}
@@ -0,0 +1,88 @@
+package stardog
+
+import (
+	"context"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDatabasesServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"databases":["myDb"]}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	list, _, err := c.Databases.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got, want := list.Databases, []string{"myDb"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Databases = %v, want %v", got, want)
+	}
+}
+
+func TestDatabasesServiceCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+			t.Fatalf("Content-Type = %q, want multipart/form-data", r.Header.Get("Content-Type"))
+		}
+
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		var sawRoot, sawFile bool
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				break
+			}
+			switch part.FormName() {
+			case "root":
+				sawRoot = true
+			case "data.ttl":
+				sawFile = true
+			}
+		}
+		if !sawRoot {
+			t.Error("multipart body missing the root field")
+		}
+		if !sawFile {
+			t.Error("multipart body missing the data.ttl file part")
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	config := map[string]string{"dbname": "myDb"}
+	file := NamedFile{Name: "data.ttl", Reader: strings.NewReader("<urn:a> <urn:b> <urn:c> .")}
+	_, err := c.Databases.Create(context.Background(), config, file)
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+}
+
+func TestDatabasesServiceAdd(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/add", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Content-Type"), mediaTypeTurtle; got != want {
+			t.Errorf("Content-Type = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	_, err := c.Databases.Add(context.Background(), "myDb", FormatTurtle, strings.NewReader("<urn:a> <urn:b> <urn:c> ."))
+	if err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package stardog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Sentinel errors that StardogError.Unwrap maps onto based on the response
+// status and Stardog's SD-Error-Code header, so callers can check them with
+// errors.Is instead of comparing status codes themselves.
+var (
+	ErrNotFound     = errors.New("stardog: not found")
+	ErrUnauthorized = errors.New("stardog: unauthorized")
+	ErrForbidden    = errors.New("stardog: forbidden")
+	ErrConflict     = errors.New("stardog: conflict")
+	ErrQueryTimeout = errors.New("stardog: query timeout")
+)
+
+// StardogError represents an error response from the Stardog API: a non-2xx
+// HTTP status together with whatever structured detail Stardog put in the
+// SD-Error-Code header and the JSON response body.
+type StardogError struct {
+	Response   *http.Response `json:"-"`
+	StatusCode int            `json:"-"`
+
+	// ErrorCode is the value of the SD-Error-Code response header, e.g.
+	// "QueryEvaluationException". Empty if Stardog did not set the header.
+	ErrorCode string `json:"-"`
+
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (e *StardogError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Code
+	}
+	if msg == "" {
+		return fmt.Sprintf("%v %v: %d", e.Response.Request.Method, sanitizeURL(e.Response.Request.URL), e.StatusCode)
+	}
+	return fmt.Sprintf("%v %v: %d %v", e.Response.Request.Method, sanitizeURL(e.Response.Request.URL), e.StatusCode, msg)
+}
+
+// Unwrap allows errors.Is(err, ErrNotFound) and similar checks to see past
+// the StardogError wrapper to the sentinel matching its status (or, for
+// timeouts, its ErrorCode).
+func (e *StardogError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusConflict:
+		return ErrConflict
+	}
+	switch e.ErrorCode {
+	case "QueryEvaluationTimeoutException", "TimeoutException":
+		return ErrQueryTimeout
+	}
+	return nil
+}
+
+// CheckResponse reports an error built from r if it does not represent a
+// successful (2xx) response, and nil otherwise. It is called by Client.Do
+// before decoding so that every service surfaces typed errors instead of
+// silently decoding a 4xx/5xx body into the caller's target struct.
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	stardogErr := &StardogError{
+		Response:   r,
+		StatusCode: r.StatusCode,
+		ErrorCode:  r.Header.Get("SD-Error-Code"),
+	}
+
+	data, readErr := io.ReadAll(r.Body)
+	if readErr == nil && len(data) > 0 {
+		_ = json.Unmarshal(data, stardogErr)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	return stardogErr
+}
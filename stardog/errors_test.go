@@ -0,0 +1,51 @@
+package stardog
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckResponse(t *testing.T) {
+	if err := CheckResponse(&http.Response{StatusCode: http.StatusOK}); err != nil {
+		t.Fatalf("CheckResponse on 200 = %v, want nil", err)
+	}
+
+	body := httptest.NewRecorder()
+	body.Header().Set("SD-Error-Code", "QueryEvaluationTimeoutException")
+	body.WriteHeader(http.StatusInternalServerError)
+	body.Body.WriteString(`{"code":"500","message":"query timed out"}`)
+	resp := body.Result()
+	resp.Request = httptest.NewRequest(http.MethodGet, "/db/query", nil)
+
+	err := CheckResponse(resp)
+	var stardogErr *StardogError
+	if !errors.As(err, &stardogErr) {
+		t.Fatalf("CheckResponse error = %T, want *StardogError", err)
+	}
+	if stardogErr.Message != "query timed out" {
+		t.Errorf("StardogError.Message = %q, want %q", stardogErr.Message, "query timed out")
+	}
+	if !errors.Is(err, ErrQueryTimeout) {
+		t.Error("errors.Is(err, ErrQueryTimeout) = false, want true")
+	}
+}
+
+func TestStardogErrorUnwrap(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusConflict, ErrConflict},
+	}
+	for _, tt := range tests {
+		e := &StardogError{StatusCode: tt.status}
+		if !errors.Is(e, tt.want) {
+			t.Errorf("status %d: errors.Is = false, want true for %v", tt.status, tt.want)
+		}
+	}
+}
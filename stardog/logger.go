@@ -0,0 +1,47 @@
+package stardog
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+// Logger is the minimal logging interface the Client and its services use
+// for internal diagnostics. Implement it to route the SDK's logs into an
+// application's existing logging pipeline. kv is an alternating sequence of
+// keys and values, matching log/slog's convention.
+type Logger interface {
+	Debug(msg string, kv ...any)
+	Info(msg string, kv ...any)
+	Warn(msg string, kv ...any)
+	Error(msg string, kv ...any)
+}
+
+// NoopLogger discards everything logged through it. It is the default
+// Logger for both NewClient and NewClientWithOptions.
+type NoopLogger struct{}
+
+func (NoopLogger) Debug(string, ...any) {}
+func (NoopLogger) Info(string, ...any)  {}
+func (NoopLogger) Warn(string, ...any)  {}
+func (NoopLogger) Error(string, ...any) {}
+
+// SlogLogger adapts a *slog.Logger to Logger.
+type SlogLogger struct {
+	Logger *slog.Logger
+}
+
+func (l SlogLogger) Debug(msg string, kv ...any) { l.Logger.Debug(msg, kv...) }
+func (l SlogLogger) Info(msg string, kv ...any)  { l.Logger.Info(msg, kv...) }
+func (l SlogLogger) Warn(msg string, kv ...any)  { l.Logger.Warn(msg, kv...) }
+func (l SlogLogger) Error(msg string, kv ...any) { l.Logger.Error(msg, kv...) }
+
+// ZapLogger adapts a *zap.SugaredLogger to Logger.
+type ZapLogger struct {
+	Logger *zap.SugaredLogger
+}
+
+func (l ZapLogger) Debug(msg string, kv ...any) { l.Logger.Debugw(msg, kv...) }
+func (l ZapLogger) Info(msg string, kv ...any)  { l.Logger.Infow(msg, kv...) }
+func (l ZapLogger) Warn(msg string, kv ...any)  { l.Logger.Warnw(msg, kv...) }
+func (l ZapLogger) Error(msg string, kv ...any) { l.Logger.Errorw(msg, kv...) }
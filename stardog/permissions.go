@@ -0,0 +1,152 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Action identifies an operation a Permission grants over a resource.
+type Action string
+
+const (
+	ActionRead    Action = "READ"
+	ActionWrite   Action = "WRITE"
+	ActionCreate  Action = "CREATE"
+	ActionDelete  Action = "DELETE"
+	ActionGrant   Action = "GRANT"
+	ActionRevoke  Action = "REVOKE"
+	ActionExecute Action = "EXECUTE"
+	ActionAll     Action = "ALL"
+)
+
+// ResourceType identifies the kind of resource named by a Permission's
+// Resource field.
+type ResourceType string
+
+const (
+	ResourceTypeDatabase   ResourceType = "db"
+	ResourceTypeUser       ResourceType = "user"
+	ResourceTypeRole       ResourceType = "role"
+	ResourceTypeNamedGraph ResourceType = "named-graph"
+	ResourceTypeAdmin      ResourceType = "admin"
+	ResourceTypeAll        ResourceType = "*"
+)
+
+// Permission grants Action over the resources named by Resource, which are
+// interpreted according to ResourceType, e.g. {ActionRead, ResourceTypeDatabase,
+// []string{"myDb"}} for READ on db:myDb.
+type Permission struct {
+	Action       Action       `json:"action"`
+	ResourceType ResourceType `json:"resource_type"`
+	Resource     []string     `json:"resource"`
+}
+
+// PermissionsList is returned by PermissionsService.ListEffective and
+// ListEffectiveForRole.
+type PermissionsList struct {
+	Permissions []Permission `json:"permissions,omitempty"`
+}
+
+type permissionCheckResult struct {
+	Result bool `json:"result"`
+}
+
+// SubjectKind distinguishes the two kinds of principal Stardog permissions
+// can be granted to: a named user, or a role that is in turn assigned to
+// users.
+type SubjectKind string
+
+const (
+	SubjectUser SubjectKind = "user"
+	SubjectRole SubjectKind = "role"
+)
+
+// PermissionsService handles communication with Stardog's permission grant,
+// revoke, and check endpoints.
+type PermissionsService service
+
+// Grant gives subject, of the given kind, permission.
+func (s *PermissionsService) Grant(ctx context.Context, kind SubjectKind, subject string, permission Permission) (*Response, error) {
+	u := fmt.Sprintf("admin/permissions/%s/%s", url.PathEscape(string(kind)), url.PathEscape(subject))
+	req, err := s.client.NewRequest("PUT", u, permission)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully granted permission", "kind", kind, "subject", subject)
+	return resp, nil
+}
+
+// Revoke removes permission from subject, of the given kind.
+func (s *PermissionsService) Revoke(ctx context.Context, kind SubjectKind, subject string, permission Permission) (*Response, error) {
+	u := fmt.Sprintf("admin/permissions/%s/%s/delete", url.PathEscape(string(kind)), url.PathEscape(subject))
+	req, err := s.client.NewRequest("POST", u, permission)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully revoked permission", "kind", kind, "subject", subject)
+	return resp, nil
+}
+
+// ListEffective returns the full set of permissions effectively granted to
+// user, including those inherited from its assigned roles.
+func (s *PermissionsService) ListEffective(ctx context.Context, user string) (*PermissionsList, *Response, error) {
+	return s.listEffective(ctx, SubjectUser, user)
+}
+
+// ListEffectiveForRole returns the permissions granted directly to role.
+func (s *PermissionsService) ListEffectiveForRole(ctx context.Context, role string) (*PermissionsList, *Response, error) {
+	return s.listEffective(ctx, SubjectRole, role)
+}
+
+func (s *PermissionsService) listEffective(ctx context.Context, kind SubjectKind, subject string) (*PermissionsList, *Response, error) {
+	u := fmt.Sprintf("admin/permissions/effective/%s/%s", url.PathEscape(string(kind)), url.PathEscape(subject))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	list := new(PermissionsList)
+	resp, err := s.client.Do(ctx, req, list)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+	s.client.logger.Info("Successfully retrieved effective permissions", "kind", kind, "subject", subject)
+	return list, resp, nil
+}
+
+// Check reports whether subject, of the given kind, currently holds
+// permission (directly, or for a user, via an assigned role).
+func (s *PermissionsService) Check(ctx context.Context, kind SubjectKind, subject string, permission Permission) (bool, *Response, error) {
+	u := fmt.Sprintf("admin/permissions/%s/%s/valid", url.PathEscape(string(kind)), url.PathEscape(subject))
+	req, err := s.client.NewRequest("POST", u, permission)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return false, nil, err
+	}
+
+	result := new(permissionCheckResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return false, resp, err
+	}
+	s.client.logger.Info("Successfully checked permission", "kind", kind, "subject", subject, "result", result.Result)
+	return result.Result, resp, nil
+}
@@ -0,0 +1,65 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPermissionsServiceGrantRole(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/permissions/role/reader", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodPut; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	perm := Permission{Action: ActionRead, ResourceType: ResourceTypeDatabase, Resource: []string{"myDb"}}
+	if _, err := c.Permissions.Grant(context.Background(), SubjectRole, "reader", perm); err != nil {
+		t.Fatalf("Grant returned error: %v", err)
+	}
+}
+
+func TestPermissionsServiceCheckUser(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/permissions/user/alice/valid", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":true}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	perm := Permission{Action: ActionRead, ResourceType: ResourceTypeDatabase, Resource: []string{"myDb"}}
+	ok, _, err := c.Permissions.Check(context.Background(), SubjectUser, "alice", perm)
+	if err != nil {
+		t.Fatalf("Check returned error: %v", err)
+	}
+	if !ok {
+		t.Error("Check = false, want true")
+	}
+}
+
+func TestPermissionsServiceListEffectiveForRole(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/permissions/effective/role/reader", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"permissions":[{"action":"READ","resource_type":"db","resource":["myDb"]}]}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	list, _, err := c.Permissions.ListEffectiveForRole(context.Background(), "reader")
+	if err != nil {
+		t.Fatalf("ListEffectiveForRole returned error: %v", err)
+	}
+	if got, want := len(list.Permissions), 1; got != want {
+		t.Fatalf("len(Permissions) = %d, want %d", got, want)
+	}
+	if got, want := list.Permissions[0].Action, ActionRead; got != want {
+		t.Errorf("Permissions[0].Action = %v, want %v", got, want)
+	}
+}
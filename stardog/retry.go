@@ -0,0 +1,105 @@
+package stardog
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures how BareDo retries a request that fails with a
+// transient error. The zero value (MaxAttempts <= 1) disables retries.
+type RetryPolicy struct {
+	// Initial is the upper bound of the delay before the first retry; each
+	// delay is drawn uniformly from [0, bound] (full jitter).
+	Initial time.Duration
+	// Max caps the delay between retries. Zero means uncapped.
+	Max time.Duration
+	// Multiplier grows the delay after each attempt, e.g. 2.0 to double it.
+	Multiplier float64
+	// MaxAttempts bounds the total number of attempts, including the first.
+	// MaxAttempts <= 1 disables retries.
+	MaxAttempts int
+	// RetryOn decides whether a response/error pair should be retried.
+	// Defaults to DefaultRetryOn when nil.
+	RetryOn func(resp *http.Response, err error) bool
+}
+
+// DefaultRetryOn retries network errors, 502/503/504, and Stardog's
+// transaction-conflict status (409).
+func DefaultRetryOn(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout, http.StatusConflict:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether resp/err qualifies for a retry under policy.
+// A nil policy disables retries.
+func shouldRetry(policy *RetryPolicy, resp *http.Response, err error) bool {
+	if policy == nil {
+		return false
+	}
+	retryOn := policy.RetryOn
+	if retryOn == nil {
+		retryOn = DefaultRetryOn
+	}
+	return retryOn(resp, err)
+}
+
+// retryDelay returns the jittered exponential backoff before the given
+// attempt (1-indexed) retries.
+func retryDelay(policy *RetryPolicy, attempt int) time.Duration {
+	multiplier := policy.Multiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	delay := float64(policy.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if policy.Max > 0 && delay > float64(policy.Max) {
+		delay = float64(policy.Max)
+	}
+
+	// Full jitter: a uniformly random delay in [0, delay].
+	return time.Duration(delay * rand.Float64())
+}
+
+type retryContextKey struct{}
+
+// WithRetry returns a RequestOption that opts a single request into retries
+// under policy, overriding Client.RetryPolicy for that request. This is the
+// mechanism non-idempotent methods (POST, PATCH) must use to be retried,
+// since BareDo only applies Client.RetryPolicy automatically to idempotent
+// methods.
+func WithRetry(policy RetryPolicy) RequestOption {
+	return func(req *http.Request) {
+		*req = *req.WithContext(context.WithValue(req.Context(), retryContextKey{}, &policy))
+	}
+}
+
+// retryPolicyFor resolves the RetryPolicy that applies to req: an explicit
+// per-request WithRetry policy always wins; otherwise Client.RetryPolicy
+// applies only to idempotent methods.
+func retryPolicyFor(req *http.Request, c *Client) *RetryPolicy {
+	if policy, ok := req.Context().Value(retryContextKey{}).(*RetryPolicy); ok {
+		return policy
+	}
+	if isIdempotent(req.Method) {
+		return c.RetryPolicy
+	}
+	return nil
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package stardog
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBareDoRetriesIdempotentRequest(t *testing.T) {
+	var calls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/databases", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"databases":[]}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+	c.RetryPolicy = &RetryPolicy{MaxAttempts: 2}
+
+	if _, _, err := c.Databases.List(context.Background()); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("server saw %d calls, want %d", got, want)
+	}
+}
+
+// TestBareDoRefusesToRetryNonRewindableBody is a regression test: retrying a
+// streaming upload whose body isn't a *bytes.Buffer/*bytes.Reader/*strings.Reader
+// would resend an already-drained reader instead of the original content, so
+// BareDo must refuse instead of silently retrying.
+func TestBareDoRefusesToRetryNonRewindableBody(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/add", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("<urn:a> <urn:b> <urn:c> ."))
+		pw.Close()
+	}()
+
+	req, err := c.NewUploadRequest("POST", "myDb/add", pr, mediaTypeTurtle, WithRetry(RetryPolicy{MaxAttempts: 2}))
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+
+	_, err = c.BareDo(context.Background(), req)
+	if err != errNonRewindableBody {
+		t.Fatalf("BareDo error = %v, want errNonRewindableBody", err)
+	}
+}
+
+func TestBareDoAllowsRetryForRewindableBody(t *testing.T) {
+	var calls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/add", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	req, err := c.NewUploadRequest("POST", "myDb/add", strings.NewReader("<urn:a> <urn:b> <urn:c> ."), mediaTypeTurtle, WithRetry(RetryPolicy{MaxAttempts: 2}))
+	if err != nil {
+		t.Fatalf("NewUploadRequest returned error: %v", err)
+	}
+
+	if _, err := c.BareDo(context.Background(), req); err != nil {
+		t.Fatalf("BareDo returned error: %v", err)
+	}
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("server saw %d calls, want %d", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// RolesService handles communication with the role related methods of the
+// Stardog security model.
+type RolesService service
+
+type createRoleRequest struct {
+	Rolename string `json:"rolename"`
+}
+
+// Create adds a new role named role.
+func (s *RolesService) Create(ctx context.Context, role string) (*Response, error) {
+	req, err := s.client.NewRequest("POST", "admin/roles", createRoleRequest{Rolename: role})
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully created role", "role", role)
+	return resp, nil
+}
+
+// Delete removes role.
+func (s *RolesService) Delete(ctx context.Context, role string) (*Response, error) {
+	u := fmt.Sprintf("admin/roles/%s", url.PathEscape(role))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully deleted role", "role", role)
+	return resp, nil
+}
+
+// List returns the names of all roles known to Stardog.
+func (s *RolesService) List(ctx context.Context) (*RolesList, *Response, error) {
+	req, err := s.client.NewRequest("GET", "admin/roles", nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	roles := new(RolesList)
+	resp, err := s.client.Do(ctx, req, roles)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+	s.client.logger.Info("Successfully retrieved roles list")
+	return roles, resp, nil
+}
@@ -0,0 +1,34 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestRolesServiceCreateAndList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/roles", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodGet:
+			w.Write([]byte(`{"roles":["reader"]}`))
+		}
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Roles.Create(context.Background(), "reader"); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	roles, _, err := c.Roles.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got, want := roles.Roles, []string{"reader"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Roles = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,268 @@
+package stardog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Media types accepted by the SPARQL query endpoints. Passing one of these as
+// QueryOptions.Accept sets the Accept header sent to Stardog. Query always
+// decodes the response as SPARQL results JSON; QueryRaw, Construct, and
+// Describe copy the response body through undecoded.
+const (
+	mediaTypeSPARQLResultsJSON = "application/sparql-results+json"
+	mediaTypeSPARQLResultsXML  = "application/sparql-results+xml"
+	mediaTypeCSV               = "text/csv"
+	mediaTypeNTriples          = "application/n-triples"
+	mediaTypeTurtle            = "text/turtle"
+	mediaTypeJSONLD            = "application/ld+json"
+	mediaTypeBoolean           = "text/boolean"
+)
+
+// SPARQLService handles communication with the SPARQL query and update
+// endpoints of the Stardog API.
+type SPARQLService service
+
+// QueryOptions specifies the optional parameters to the SPARQLService.Query,
+// Ask, Construct, and Describe methods.
+type QueryOptions struct {
+	// Accept controls the media type requested from Stardog. Query always
+	// decodes the response as SPARQL results JSON whatever Accept is set to.
+	// Defaults to application/sparql-results+json for Query and QueryRaw and
+	// to text/turtle for Construct and Describe; Ask always requests
+	// text/boolean.
+	Accept string
+
+	// Reasoning enables reasoning for the query when true.
+	Reasoning bool
+
+	// Timeout bounds query execution, e.g. "30s". Empty means no timeout.
+	Timeout string
+
+	DefaultGraphURI []string
+	NamedGraphURI   []string
+}
+
+// UpdateOptions specifies the optional parameters to the SPARQLService.Update
+// method.
+type UpdateOptions struct {
+	Reasoning bool
+	Timeout   string
+
+	UsingGraphURI      []string
+	UsingNamedGraphURI []string
+}
+
+// SPARQLResults is the decoded form of the SPARQL 1.1 Query Results JSON
+// Format, as returned by Query when no custom Accept media type is set.
+type SPARQLResults struct {
+	Head    SPARQLHead         `json:"head"`
+	Results *SPARQLResultsBody `json:"results,omitempty"`
+	Boolean *bool              `json:"boolean,omitempty"`
+}
+
+type SPARQLHead struct {
+	Vars []string `json:"vars,omitempty"`
+	Link []string `json:"link,omitempty"`
+}
+
+type SPARQLResultsBody struct {
+	Bindings []map[string]SPARQLBinding `json:"bindings"`
+}
+
+// SPARQLBinding is a single variable binding within a result row.
+type SPARQLBinding struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	DataType string `json:"datatype,omitempty"`
+	Lang     string `json:"xml:lang,omitempty"`
+}
+
+func (o *QueryOptions) accept() string {
+	if o == nil || o.Accept == "" {
+		return mediaTypeSPARQLResultsJSON
+	}
+	return o.Accept
+}
+
+// values renders the query and its parameters as the URL query string
+// Stardog expects for GET /{database}/query requests.
+func (o *QueryOptions) values(sparql string) url.Values {
+	v := url.Values{}
+	v.Set("query", sparql)
+	if o == nil {
+		return v
+	}
+	if o.Reasoning {
+		v.Set("reasoning", "true")
+	}
+	if o.Timeout != "" {
+		v.Set("timeout", o.Timeout)
+	}
+	for _, g := range o.DefaultGraphURI {
+		v.Add("default-graph-uri", g)
+	}
+	for _, g := range o.NamedGraphURI {
+		v.Add("named-graph-uri", g)
+	}
+	return v
+}
+
+func (o *UpdateOptions) values(sparql string) url.Values {
+	v := url.Values{}
+	v.Set("update", sparql)
+	if o == nil {
+		return v
+	}
+	if o.Reasoning {
+		v.Set("reasoning", "true")
+	}
+	if o.Timeout != "" {
+		v.Set("timeout", o.Timeout)
+	}
+	for _, g := range o.UsingGraphURI {
+		v.Add("using-graph-uri", g)
+	}
+	for _, g := range o.UsingNamedGraphURI {
+		v.Add("using-named-graph-uri", g)
+	}
+	return v
+}
+
+// withAccept returns a RequestOption that overrides the Accept header set by
+// NewRequest.
+func withAccept(mediaType string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set("Accept", mediaType)
+	}
+}
+
+// Query executes a SPARQL SELECT query against database and decodes the
+// response as SPARQLResults. Set opts.Accept to a non-JSON media type and use
+// QueryRaw instead if the bindings should not be parsed, e.g. to stream
+// text/csv straight through to a file.
+func (s *SPARQLService) Query(ctx context.Context, database, sparql string, opts *QueryOptions) (*SPARQLResults, *Response, error) {
+	req, err := s.newQueryRequest(database, sparql, opts)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	results := new(SPARQLResults)
+	resp, err := s.client.Do(ctx, req, results)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+	s.client.logger.Info("Successfully executed SPARQL query", "database", database)
+	return results, resp, nil
+}
+
+// QueryRaw executes a SPARQL query and copies the raw response body to w
+// without decoding it, using opts.Accept (or mediaTypeSPARQLResultsJSON by
+// default) as the requested media type. It is the mechanism behind Ask,
+// Construct, and Describe and is also useful for Query when callers want
+// text/csv or application/sparql-results+xml instead of decoded bindings.
+func (s *SPARQLService) QueryRaw(ctx context.Context, database, sparql string, opts *QueryOptions, w io.Writer) (*Response, error) {
+	req, err := s.newQueryRequest(database, sparql, opts)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, w)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully executed SPARQL query", "database", database)
+	return resp, nil
+}
+
+func (s *SPARQLService) newQueryRequest(database, sparql string, opts *QueryOptions) (*http.Request, error) {
+	u := fmt.Sprintf("%s/query?%s", url.PathEscape(database), opts.values(sparql).Encode())
+	return s.client.NewRequest("GET", u, nil, withAccept(opts.accept()))
+}
+
+// Update executes a SPARQL UPDATE (INSERT/DELETE) against database. The
+// update text and its parameters travel as an application/x-www-form-urlencoded
+// body rather than a URL query string, so a large bulk update does not risk
+// hitting URL/request-line length limits. Stardog returns no body on
+// success, so the response is returned unparsed.
+func (s *SPARQLService) Update(ctx context.Context, database, sparql string, opts *UpdateOptions) (*Response, error) {
+	form := opts.values(sparql).Encode()
+	u := fmt.Sprintf("%s/update", url.PathEscape(database))
+
+	req, err := s.client.NewUploadRequest("POST", u, strings.NewReader(form), "application/x-www-form-urlencoded")
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully executed SPARQL update", "database", database)
+	return resp, nil
+}
+
+// Ask executes a SPARQL ASK query and returns its boolean result.
+func (s *SPARQLService) Ask(ctx context.Context, database, sparql string, opts *QueryOptions) (bool, *Response, error) {
+	var buf strings.Builder
+	resp, err := s.QueryRaw(ctx, database, sparql, withAcceptOverride(opts, mediaTypeBoolean), &buf)
+	if err != nil {
+		return false, resp, err
+	}
+
+	result, err := strconv.ParseBool(strings.TrimSpace(buf.String()))
+	if err != nil {
+		return false, resp, err
+	}
+	return result, resp, nil
+}
+
+// Construct executes a SPARQL CONSTRUCT query, streaming the resulting RDF
+// graph to w in the serialization named by opts.Accept (text/turtle by
+// default).
+func (s *SPARQLService) Construct(ctx context.Context, database, sparql string, opts *QueryOptions, w io.Writer) (*Response, error) {
+	return s.QueryRaw(ctx, database, sparql, withAcceptDefault(opts, mediaTypeTurtle), w)
+}
+
+// Describe executes a SPARQL DESCRIBE query, streaming the resulting RDF
+// graph to w in the serialization named by opts.Accept (text/turtle by
+// default).
+func (s *SPARQLService) Describe(ctx context.Context, database, sparql string, opts *QueryOptions, w io.Writer) (*Response, error) {
+	return s.QueryRaw(ctx, database, sparql, withAcceptDefault(opts, mediaTypeTurtle), w)
+}
+
+// withAcceptDefault returns a copy of opts with Accept set to mediaType when
+// the caller did not already choose one, leaving an explicit caller choice
+// untouched.
+func withAcceptDefault(opts *QueryOptions, mediaType string) *QueryOptions {
+	cp := QueryOptions{}
+	if opts != nil {
+		cp = *opts
+	}
+	if cp.Accept == "" {
+		cp.Accept = mediaType
+	}
+	return &cp
+}
+
+// withAcceptOverride returns a copy of opts with Accept forced to mediaType,
+// regardless of what the caller set.
+func withAcceptOverride(opts *QueryOptions, mediaType string) *QueryOptions {
+	cp := QueryOptions{}
+	if opts != nil {
+		cp = *opts
+	}
+	cp.Accept = mediaType
+	return &cp
+}
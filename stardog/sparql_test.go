@@ -0,0 +1,86 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSPARQLServiceQuery(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/query", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodGet; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		if got, want := r.URL.Query().Get("query"), "select * where { ?s ?p ?o }"; got != want {
+			t.Errorf("query param = %q, want %q", got, want)
+		}
+		w.Header().Set("Content-Type", mediaTypeSPARQLResultsJSON)
+		w.Write([]byte(`{"head":{"vars":["s"]},"results":{"bindings":[{"s":{"type":"uri","value":"urn:a"}}]}}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	results, _, err := c.SPARQL.Query(context.Background(), "myDb", "select * where { ?s ?p ?o }", nil)
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if got, want := results.Head.Vars[0], "s"; got != want {
+		t.Errorf("Head.Vars[0] = %q, want %q", got, want)
+	}
+	if got, want := len(results.Results.Bindings), 1; got != want {
+		t.Errorf("len(Results.Bindings) = %d, want %d", got, want)
+	}
+}
+
+func TestSPARQLServiceUpdate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/update", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodPost; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		if got, want := r.URL.RawQuery, ""; got != want {
+			t.Errorf("update text leaked into the URL query string: %q", got)
+		}
+		if got, want := r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"; got != want {
+			t.Errorf("Content-Type = %q, want %q", got, want)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm: %v", err)
+		}
+		if got, want := r.PostForm.Get("update"), "insert data { <urn:a> <urn:b> <urn:c> }"; got != want {
+			t.Errorf("update body = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	_, err := c.SPARQL.Update(context.Background(), "myDb", "insert data { <urn:a> <urn:b> <urn:c> }", nil)
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+}
+
+func TestSPARQLServiceAsk(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/query", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Accept"), mediaTypeBoolean; got != want {
+			t.Errorf("Accept = %q, want %q", got, want)
+		}
+		w.Write([]byte("true"))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	ok, _, err := c.SPARQL.Ask(context.Background(), "myDb", "ask { ?s ?p ?o }", nil)
+	if err != nil {
+		t.Fatalf("Ask returned error: %v", err)
+	}
+	if !ok {
+		t.Error("Ask = false, want true")
+	}
+}
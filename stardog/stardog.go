@@ -13,12 +13,21 @@ import (
 	"strings"
 	"sync"
 	"time"
-
-	"go.uber.org/zap"
 )
 
 var errNonNilContext = errors.New("context must be non-nil")
 
+// errNonRewindableBody is returned by BareDo, before sending, when a request
+// whose body cannot be rewound could be retried: a RetryPolicy or WithRetry
+// allows more than one attempt, or a TokenSource is installed (a 401 triggers
+// a reauth-and-retry). NewRequest's
+// json-encoded bodies and NewUploadRequest bodies backed by *bytes.Buffer,
+// *bytes.Reader, or *strings.Reader populate req.GetBody automatically;
+// streaming uploads passed to NewUploadRequest as an arbitrary io.Reader do
+// not, so resending them after a partial read would silently corrupt the
+// request instead of repeating it.
+var errNonRewindableBody = errors.New("stardog: cannot retry request: body does not support being rewound (no GetBody)")
+
 const (
 	mediaTypeV3    = "application/json"
 	defaultBaseURL = "http://localhost:5820/"
@@ -43,10 +52,28 @@ type Client struct {
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the Stardog API.
-	Users *UsersService
+	Users        *UsersService
+	Roles        *RolesService
+	Permissions  *PermissionsService
+	SPARQL       *SPARQLService
+	Databases    *DatabasesService
+	Transactions *TransactionsService
 
 	// Basic auth used for setting authentification
 	BasicAuth *BasicAuth
+
+	// tokenSource, if set via SetTokenAuth, supplies the Bearer token BareDo
+	// attaches to every request in place of BasicAuth.
+	tokenSource TokenSource
+
+	// RetryPolicy, if set, governs automatic retries of idempotent requests
+	// (GET, HEAD, OPTIONS, PUT, DELETE) in BareDo. Non-idempotent requests
+	// are only retried when the caller opts in per-request with WithRetry.
+	RetryPolicy *RetryPolicy
+
+	// logger receives internal diagnostics. Defaults to NoopLogger; use
+	// WithLogger with NewClientWithOptions to route it elsewhere.
+	logger Logger
 }
 
 type service struct {
@@ -61,9 +88,56 @@ func (c *Client) Client() *http.Client {
 	return &clientCopy
 }
 
+// NewClient creates a new Stardog API client with the given http.Client and
+// base URL. Passing nil/"" falls back to a default http.Client and
+// defaultBaseURL respectively. Internal diagnostics are discarded; use
+// NewClientWithOptions with WithLogger to capture them.
 func NewClient(httpClient *http.Client, baseURL string) *Client {
-	logger, _ := zap.NewDevelopment()
-	defer logger.Sync()
+	return newClient(httpClient, baseURL, NoopLogger{})
+}
+
+// ClientOption configures a Client built by NewClientWithOptions.
+type ClientOption func(*clientConfig)
+
+type clientConfig struct {
+	httpClient *http.Client
+	baseURL    string
+	logger     Logger
+}
+
+// WithHTTPClient sets the http.Client a Client built by NewClientWithOptions
+// uses, in place of the default http.Client{}.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(cfg *clientConfig) { cfg.httpClient = httpClient }
+}
+
+// WithBaseURL sets the base URL a Client built by NewClientWithOptions
+// talks to, in place of defaultBaseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(cfg *clientConfig) { cfg.baseURL = baseURL }
+}
+
+// WithLogger routes a Client's internal diagnostics through logger instead
+// of the default NoopLogger.
+func WithLogger(logger Logger) ClientOption {
+	return func(cfg *clientConfig) { cfg.logger = logger }
+}
+
+// NewClientWithOptions creates a new Stardog API client configured by opts,
+// an alternative to NewClient's fixed (httpClient, baseURL) signature for
+// callers that also want to set a Logger.
+func NewClientWithOptions(opts ...ClientOption) *Client {
+	cfg := &clientConfig{logger: NoopLogger{}}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return newClient(cfg.httpClient, cfg.baseURL, cfg.logger)
+}
+
+func newClient(httpClient *http.Client, baseURL string, logger Logger) *Client {
+	if logger == nil {
+		logger = NoopLogger{}
+	}
 
 	if httpClient == nil {
 		httpClient = &http.Client{}
@@ -72,17 +146,22 @@ func NewClient(httpClient *http.Client, baseURL string) *Client {
 
 	if baseURL == "" {
 		baseURL = defaultBaseURL
-		logger.Info("Base URL not provided, using default URL: %s", zap.String("url", baseURL))
+		logger.Info("Base URL not provided, using default URL.", "url", baseURL)
 	}
 
 	parsedBaseURL, err := url.Parse(baseURL)
 	if err != nil {
-		logger.Fatal("Error parsing base URL", zap.Error(err))
+		panic(fmt.Errorf("stardog: error parsing base URL %q: %w", baseURL, err))
 	}
 
-	c := &Client{client: httpClient, BaseURL: parsedBaseURL}
+	c := &Client{client: httpClient, BaseURL: parsedBaseURL, logger: logger}
 	c.common.client = c
 	c.Users = (*UsersService)(&c.common)
+	c.Roles = (*RolesService)(&c.common)
+	c.Permissions = (*PermissionsService)(&c.common)
+	c.SPARQL = (*SPARQLService)(&c.common)
+	c.Databases = (*DatabasesService)(&c.common)
+	c.Transactions = (*TransactionsService)(&c.common)
 
 	logger.Info("New client created successfully.")
 	return c
@@ -131,11 +210,28 @@ type Response struct {
 
 	// token's expiration date
 	TokenExpiration Timestamp
+
+	// Attempts is the number of HTTP attempts BareDo made to produce this
+	// Response, including the final one. It is 1 unless a RetryPolicy or a
+	// 401 reauth-and-retry caused one or more retries.
+	Attempts int
 }
 
 // RequestOption represents an option that can modify an http.Request.
 type RequestOption func(req *http.Request)
 
+type skipTokenAuthKey struct{}
+
+// skipTokenAuth marks req so BareDo does not call Client.tokenSource.Token
+// to authenticate it. TokenSource implementations that log in through the
+// Client (e.g. PasswordToken) use this on their own login request so it
+// does not recursively call back into Token.
+func skipTokenAuth() RequestOption {
+	return func(req *http.Request) {
+		*req = *req.WithContext(context.WithValue(req.Context(), skipTokenAuthKey{}, true))
+	}
+}
+
 func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...RequestOption) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
@@ -181,6 +277,44 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}, opts ...Req
 	return req, nil
 }
 
+// NewUploadRequest creates a request for sending body as-is, without the
+// JSON encoding NewRequest applies. Unlike NewRequest, body is streamed
+// straight into the request rather than buffered, which matters for large
+// RDF payloads. contentType is set on the request when non-empty.
+func (c *Client) NewUploadRequest(method, urlStr string, body io.Reader, contentType string, opts ...RequestOption) (*http.Request, error) {
+	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+	}
+
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", mediaTypeV3)
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	if c.BasicAuth != nil {
+		req.SetBasicAuth(c.BasicAuth.username, c.BasicAuth.password)
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	return req, nil
+}
+
 type Rate struct {
 	// The number of requests per hour the client is currently limited to.
 	Limit int `json:"limit"`
@@ -210,8 +344,26 @@ func sanitizeURL(uri *url.URL) *url.URL {
 	return uri
 }
 
+// mergedContext adopts ctx's cancellation and deadline for the HTTP
+// round-trip while still resolving Value lookups that ctx does not answer
+// against values: req's
+// own context, built by the RequestOptions NewRequest/NewUploadRequest
+// applied (e.g. skipTokenAuth, WithRetry), would otherwise be discarded
+// wholesale by req.WithContext(ctx).
+type mergedContext struct {
+	context.Context
+	values context.Context
+}
+
+func (m mergedContext) Value(key interface{}) interface{} {
+	if v := m.Context.Value(key); v != nil {
+		return v
+	}
+	return m.values.Value(key)
+}
+
 func withContext(ctx context.Context, req *http.Request) *http.Request {
-	return req.WithContext(ctx)
+	return req.WithContext(mergedContext{Context: ctx, values: req.Context()})
 }
 
 func newResponse(r *http.Response) *Response {
@@ -296,7 +448,75 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 
 	req = withContext(ctx, req)
 
-	resp, err := c.client.Do(req)
+	skipAuth, _ := req.Context().Value(skipTokenAuthKey{}).(bool)
+
+	policy := retryPolicyFor(req, c)
+	maxAttempts := 1
+	if policy != nil && policy.MaxAttempts > maxAttempts {
+		maxAttempts = policy.MaxAttempts
+	}
+
+	// A 401 triggers a reauth-and-retry below regardless of maxAttempts, so
+	// the rewindability guard must cover that path too, not just an explicit
+	// RetryPolicy: otherwise a token that expires mid-session would silently
+	// resend an already-drained streaming upload on the reauth attempt.
+	mayRetryBody := maxAttempts > 1 || (c.tokenSource != nil && !skipAuth)
+	if mayRetryBody && req.Body != nil && req.GetBody == nil {
+		return nil, errNonRewindableBody
+	}
+
+	var resp *http.Response
+	var err error
+	attempt := 0
+
+	authRetried := false
+
+	for {
+		attempt++
+
+		if attempt > 1 && req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, bodyErr
+			}
+			req.Body = body
+		}
+
+		if c.tokenSource != nil && !skipAuth {
+			token, _, tokenErr := c.tokenSource.Token(ctx)
+			if tokenErr != nil {
+				return nil, tokenErr
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err = c.client.Do(req)
+
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && c.tokenSource != nil && !skipAuth && !authRetried {
+			authRetried = true
+			if inv, ok := c.tokenSource.(tokenInvalidator); ok {
+				inv.invalidate()
+			}
+			resp.Body.Close()
+			continue
+		}
+
+		if attempt < maxAttempts && shouldRetry(policy, resp, err) {
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(retryDelay(policy, attempt)):
+			}
+			continue
+		}
+
+		break
+	}
+
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -318,6 +538,7 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	}
 
 	response := newResponse(resp)
+	response.Attempts = attempt
 
 	return response, err
 }
@@ -330,6 +551,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	defer resp.Body.Close()
 
+	if err := CheckResponse(resp.Response); err != nil {
+		return resp, err
+	}
+
 	switch v := v.(type) {
 	case nil:
 	case io.Writer:
@@ -350,3 +575,10 @@ func (c *Client) SetBasicAuth(username string, password string) {
 	basicAuth := BasicAuth{username: username, password: password}
 	c.BasicAuth = &basicAuth
 }
+
+// SetTokenAuth installs ts as the client's authentication source. Every
+// subsequent request carries a Bearer header sourced from ts.Token instead
+// of BasicAuth.
+func (c *Client) SetTokenAuth(ts TokenSource) {
+	c.tokenSource = ts
+}
@@ -1,27 +1,41 @@
 package stardog
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
 
 func TestNewClient(t *testing.T) {
-	c := NewClient(nil)
+	c := NewClient(nil, "")
 
 	if got, want := c.BaseURL.String(), defaultBaseURL; got != want {
 		t.Errorf("NewClient BaseURL is %v, want %v", got, want)
 	}
-	if got, want := c.UserAgent; got != want {
+	if got, want := c.UserAgent, ""; got != want {
 		t.Errorf("NewClient UserAgent is %v, want %v", got, want)
 	}
 
-	c2 := NewClient(nil)
+	c2 := NewClient(nil, "")
 	if c.client == c2.client {
 		t.Error("NewClient returned same http.Clients, but they should differ")
 	}
 }
 
 func TestClient(t *testing.T) {
-	c := NewClient(nil)
+	c := NewClient(nil, "")
 	c2 := c.Client()
 	if c.client == c2 {
 		t.Error("Client returned same http.Client, but should be different")
 	}
 }
+
+// newTestClient starts an httptest.Server whose mux handles requests, and
+// returns a Client pointed at it. Callers must call the returned func to
+// shut the server down.
+func newTestClient(t *testing.T, mux *http.ServeMux) (*Client, func()) {
+	t.Helper()
+	server := httptest.NewServer(mux)
+	c := NewClient(nil, server.URL+"/")
+	return c, server.Close
+}
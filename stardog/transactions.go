@@ -0,0 +1,233 @@
+package stardog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ErrTxClosed is returned by Add, Remove, Query, and Update when called after
+// the transaction has already been committed or rolled back. Commit and
+// Rollback on a finalized Tx are no-ops that return a nil error.
+var ErrTxClosed = errors.New("stardog: transaction is closed")
+
+// TransactionsService handles communication with Stardog's transaction
+// lifecycle endpoints.
+type TransactionsService service
+
+// Tx represents an open Stardog transaction. It reuses the parent Client for
+// all HTTP communication, rewriting request paths to include the
+// transaction ID. A Tx must be finalized with Commit or Rollback; WithTx
+// does this automatically based on whether the callback returns an error.
+type Tx struct {
+	client   *Client
+	database string
+	id       string
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// ID returns the transaction ID Stardog assigned to this Tx.
+func (tx *Tx) ID() string {
+	return tx.id
+}
+
+// Begin opens a new transaction against database.
+func (s *TransactionsService) Begin(ctx context.Context, database string) (*Tx, *Response, error) {
+	u := fmt.Sprintf("%s/transaction/begin", url.PathEscape(database))
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	var id strings.Builder
+	resp, err := s.client.Do(ctx, req, &id)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+
+	tx := &Tx{
+		client:   s.client,
+		database: database,
+		id:       strings.TrimSpace(id.String()),
+	}
+	s.client.logger.Info("Successfully began transaction", "database", database, "transaction", tx.id)
+	return tx, resp, nil
+}
+
+// checkOpen reports ErrTxClosed if tx has already been committed or rolled
+// back.
+func (tx *Tx) checkOpen() error {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	if tx.closed {
+		return ErrTxClosed
+	}
+	return nil
+}
+
+// Add streams data, serialized as format, into the transaction.
+func (tx *Tx) Add(ctx context.Context, format Format, data io.Reader) (*Response, error) {
+	if err := tx.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s/add", url.PathEscape(tx.database), url.PathEscape(tx.id))
+	req, err := tx.client.NewUploadRequest("POST", u, data, format.contentType())
+	if err != nil {
+		tx.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := tx.client.Do(ctx, req, nil)
+	if err != nil {
+		tx.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	tx.client.logger.Info("Successfully added data within transaction", "database", tx.database, "transaction", tx.id)
+	return resp, nil
+}
+
+// Remove streams data, serialized as format, and removes any matching
+// statements within the transaction.
+func (tx *Tx) Remove(ctx context.Context, format Format, data io.Reader) (*Response, error) {
+	if err := tx.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s/remove", url.PathEscape(tx.database), url.PathEscape(tx.id))
+	req, err := tx.client.NewUploadRequest("POST", u, data, format.contentType())
+	if err != nil {
+		tx.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := tx.client.Do(ctx, req, nil)
+	if err != nil {
+		tx.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	tx.client.logger.Info("Successfully removed data within transaction", "database", tx.database, "transaction", tx.id)
+	return resp, nil
+}
+
+// Query executes a SPARQL SELECT query within the transaction, seeing its
+// uncommitted changes.
+func (tx *Tx) Query(ctx context.Context, sparql string, opts *QueryOptions) (*SPARQLResults, *Response, error) {
+	if err := tx.checkOpen(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("%s/%s/query?%s", url.PathEscape(tx.database), url.PathEscape(tx.id), opts.values(sparql).Encode())
+	req, err := tx.client.NewRequest("GET", u, nil, withAccept(opts.accept()))
+	if err != nil {
+		tx.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	results := new(SPARQLResults)
+	resp, err := tx.client.Do(ctx, req, results)
+	if err != nil {
+		tx.client.logger.Error("Error performing request", "error", err)
+		return nil, resp, err
+	}
+	tx.client.logger.Info("Successfully executed SPARQL query within transaction", "database", tx.database, "transaction", tx.id)
+	return results, resp, nil
+}
+
+// Update executes a SPARQL UPDATE within the transaction. The update text
+// and its parameters travel as an application/x-www-form-urlencoded body
+// rather than a URL query string, so a large bulk update does not risk
+// hitting URL/request-line length limits.
+func (tx *Tx) Update(ctx context.Context, sparql string, opts *UpdateOptions) (*Response, error) {
+	if err := tx.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	form := opts.values(sparql).Encode()
+	u := fmt.Sprintf("%s/%s/update", url.PathEscape(tx.database), url.PathEscape(tx.id))
+	req, err := tx.client.NewUploadRequest("POST", u, strings.NewReader(form), "application/x-www-form-urlencoded")
+	if err != nil {
+		tx.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := tx.client.Do(ctx, req, nil)
+	if err != nil {
+		tx.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	tx.client.logger.Info("Successfully executed SPARQL update within transaction", "database", tx.database, "transaction", tx.id)
+	return resp, nil
+}
+
+// Commit persists the transaction's changes. Commit is idempotent: once it
+// has finalized the transaction, calling it (or Rollback) again is a no-op.
+func (tx *Tx) Commit(ctx context.Context) (*Response, error) {
+	return tx.finalize(ctx, "commit")
+}
+
+// Rollback discards the transaction's changes. Rollback is idempotent: once
+// it has finalized the transaction, calling it (or Commit) again is a
+// no-op.
+func (tx *Tx) Rollback(ctx context.Context) (*Response, error) {
+	return tx.finalize(ctx, "rollback")
+}
+
+// finalize holds tx.mu for the whole call, not just the closed check, so
+// that a failed attempt does not mark the transaction closed: a caller who
+// retries Commit/Rollback after a network error or 5xx must still reach the
+// server rather than getting a silent no-op.
+func (tx *Tx) finalize(ctx context.Context, action string) (*Response, error) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+
+	if tx.closed {
+		return nil, nil
+	}
+
+	u := fmt.Sprintf("%s/transaction/%s/%s", url.PathEscape(tx.database), action, url.PathEscape(tx.id))
+	req, err := tx.client.NewRequest("POST", u, nil)
+	if err != nil {
+		tx.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := tx.client.Do(ctx, req, nil)
+	if err != nil {
+		tx.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+
+	tx.closed = true
+	tx.client.logger.Info("Successfully finalized transaction", "database", tx.database, "transaction", tx.id, "action", action)
+	return resp, nil
+}
+
+// WithTx begins a transaction against database, invokes fn with it, and
+// commits on a nil return or rolls back otherwise. This mirrors the
+// database/sql convention of scoping a transaction to a callback so callers
+// cannot forget to finalize it.
+func (c *Client) WithTx(ctx context.Context, database string, fn func(*Tx) error) error {
+	tx, _, err := c.Transactions.Begin(ctx, database)
+	if err != nil {
+		return err
+	}
+
+	if fnErr := fn(tx); fnErr != nil {
+		if _, rbErr := tx.Rollback(ctx); rbErr != nil {
+			return fmt.Errorf("%w (rollback also failed: %v)", fnErr, rbErr)
+		}
+		return fnErr
+	}
+
+	_, err = tx.Commit(ctx)
+	return err
+}
@@ -0,0 +1,99 @@
+package stardog
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTransactionsServiceBeginCommit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/transaction/begin", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("txn-1"))
+	})
+	mux.HandleFunc("/myDb/transaction/commit/txn-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	tx, _, err := c.Transactions.Begin(context.Background(), "myDb")
+	if err != nil {
+		t.Fatalf("Begin returned error: %v", err)
+	}
+	if got, want := tx.ID(), "txn-1"; got != want {
+		t.Errorf("ID() = %q, want %q", got, want)
+	}
+
+	if _, err := tx.Commit(context.Background()); err != nil {
+		t.Fatalf("Commit returned error: %v", err)
+	}
+}
+
+func TestTransactionsServiceUpdate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/txn-1/update", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.RawQuery, ""; got != want {
+			t.Errorf("update text leaked into the URL query string: %q", got)
+		}
+		if got, want := r.Header.Get("Content-Type"), "application/x-www-form-urlencoded"; got != want {
+			t.Errorf("Content-Type = %q, want %q", got, want)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("ParseForm: %v", err)
+		}
+		if got, want := r.PostForm.Get("update"), "insert data { <urn:a> <urn:b> <urn:c> }"; got != want {
+			t.Errorf("update body = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	tx := &Tx{client: c, database: "myDb", id: "txn-1"}
+	if _, err := tx.Update(context.Background(), "insert data { <urn:a> <urn:b> <urn:c> }", nil); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+}
+
+// TestTxFinalizeRetriesAfterFailure is a regression test: a failed Commit
+// must not leave the transaction marked closed, or a caller that retries
+// after a transient server error gets a silent no-op instead of reaching
+// the server.
+func TestTxFinalizeRetriesAfterFailure(t *testing.T) {
+	var calls int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/myDb/transaction/commit/txn-1", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	tx := &Tx{client: c, database: "myDb", id: "txn-1"}
+
+	if _, err := tx.Commit(context.Background()); err == nil {
+		t.Fatal("first Commit returned nil error, want the 500 to surface")
+	}
+	if tx.closed {
+		t.Fatal("tx.closed = true after a failed Commit, want false")
+	}
+
+	if _, err := tx.Commit(context.Background()); err != nil {
+		t.Fatalf("second Commit returned error: %v", err)
+	}
+	if got, want := atomic.LoadInt32(&calls), int32(2); got != want {
+		t.Errorf("server saw %d commit calls, want %d", got, want)
+	}
+	if !tx.closed {
+		t.Error("tx.closed = false after a successful Commit, want true")
+	}
+}
@@ -2,8 +2,8 @@ package stardog
 
 import (
 	"context"
-
-	"go.uber.org/zap"
+	"fmt"
+	"net/url"
 )
 
 // UsersService handles communication with the user related
@@ -14,26 +14,161 @@ type UsersList struct {
 	Users []string `json:"users,omitempty"`
 }
 
+// RolesList is returned by UsersService.ListRoles and RolesService.List.
+type RolesList struct {
+	Roles []string `json:"roles,omitempty"`
+}
+
 // Return list of existing users in database
 func (s *UsersService) List(ctx context.Context) (*UsersList, *Response, error) {
-	// Create new logger
-	logger, _ := zap.NewDevelopment()
-	defer logger.Sync()
-
 	u := "admin/users"
 	req, err := s.client.NewRequest("GET", u, nil)
 
 	if err != nil {
-		logger.Error("Error creating new request", zap.Error(err))
+		s.client.logger.Error("Error creating new request", "error", err)
 		return nil, nil, err
 	}
 
 	users := new(UsersList)
 	resp, err := s.client.Do(ctx, req, users)
 	if err != nil {
-		logger.Error("Error performing request", zap.Error(err))
+		s.client.logger.Error("Error performing request", "error", err)
 		return users, resp, err
 	}
-	logger.Info("Successfully retrieved users list")
+	s.client.logger.Info("Successfully retrieved users list")
 	return users, resp, nil
 }
+
+type createUserRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// Create adds a new user named username with the given password.
+func (s *UsersService) Create(ctx context.Context, username, password string) (*Response, error) {
+	req, err := s.client.NewRequest("POST", "admin/users", createUserRequest{Username: username, Password: password})
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully created user", "username", username)
+	return resp, nil
+}
+
+// Delete removes username.
+func (s *UsersService) Delete(ctx context.Context, username string) (*Response, error) {
+	u := fmt.Sprintf("admin/users/%s", url.PathEscape(username))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully deleted user", "username", username)
+	return resp, nil
+}
+
+type changePasswordRequest struct {
+	Password string `json:"password"`
+}
+
+// ChangePassword sets a new password for username.
+func (s *UsersService) ChangePassword(ctx context.Context, username, password string) (*Response, error) {
+	u := fmt.Sprintf("admin/users/%s/pwd", url.PathEscape(username))
+	req, err := s.client.NewRequest("PUT", u, changePasswordRequest{Password: password})
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully changed user password", "username", username)
+	return resp, nil
+}
+
+type enabledRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Enable reactivates username, allowing it to authenticate again.
+func (s *UsersService) Enable(ctx context.Context, username string) (*Response, error) {
+	return s.setEnabled(ctx, username, true)
+}
+
+// Disable deactivates username, preventing it from authenticating.
+func (s *UsersService) Disable(ctx context.Context, username string) (*Response, error) {
+	return s.setEnabled(ctx, username, false)
+}
+
+func (s *UsersService) setEnabled(ctx context.Context, username string, enabled bool) (*Response, error) {
+	u := fmt.Sprintf("admin/users/%s/enabled", url.PathEscape(username))
+	req, err := s.client.NewRequest("PUT", u, enabledRequest{Enabled: enabled})
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully updated user enabled state", "username", username, "enabled", enabled)
+	return resp, nil
+}
+
+type assignRoleRequest struct {
+	Rolename string `json:"rolename"`
+}
+
+// AssignRole grants role to username.
+func (s *UsersService) AssignRole(ctx context.Context, username, role string) (*Response, error) {
+	u := fmt.Sprintf("admin/users/%s/roles", url.PathEscape(username))
+	req, err := s.client.NewRequest("POST", u, assignRoleRequest{Rolename: role})
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, err
+	}
+
+	resp, err := s.client.Do(ctx, req, nil)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return resp, err
+	}
+	s.client.logger.Info("Successfully assigned role", "username", username, "role", role)
+	return resp, nil
+}
+
+// ListRoles returns the roles assigned to username.
+func (s *UsersService) ListRoles(ctx context.Context, username string) (*RolesList, *Response, error) {
+	u := fmt.Sprintf("admin/users/%s/roles", url.PathEscape(username))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		s.client.logger.Error("Error creating new request", "error", err)
+		return nil, nil, err
+	}
+
+	roles := new(RolesList)
+	resp, err := s.client.Do(ctx, req, roles)
+	if err != nil {
+		s.client.logger.Error("Error performing request", "error", err)
+		return roles, resp, err
+	}
+	s.client.logger.Info("Successfully retrieved roles list", "username", username)
+	return roles, resp, nil
+}
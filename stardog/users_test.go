@@ -0,0 +1,162 @@
+package stardog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestUsersServiceList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodGet; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		w.Write([]byte(`{"users":["alice"]}`))
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	users, _, err := c.Users.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got, want := users.Users, []string{"alice"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Users = %v, want %v", got, want)
+	}
+}
+
+func TestUsersServiceCreate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodPost; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		var body createUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		if got, want := body, (createUserRequest{Username: "alice", Password: "hunter2"}); got != want {
+			t.Errorf("body = %+v, want %+v", got, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Users.Create(context.Background(), "alice", "hunter2"); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+}
+
+func TestUsersServiceDelete(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodDelete; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Users.Delete(context.Background(), "alice"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+}
+
+func TestUsersServiceChangePassword(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users/alice/pwd", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodPut; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		var body changePasswordRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		if got, want := body.Password, "newpass"; got != want {
+			t.Errorf("Password = %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Users.ChangePassword(context.Background(), "alice", "newpass"); err != nil {
+		t.Fatalf("ChangePassword returned error: %v", err)
+	}
+}
+
+func TestUsersServiceEnableDisable(t *testing.T) {
+	var lastEnabled *bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users/alice/enabled", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Method, http.MethodPut; got != want {
+			t.Errorf("method = %v, want %v", got, want)
+		}
+		var body enabledRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		lastEnabled = &body.Enabled
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Users.Enable(context.Background(), "alice"); err != nil {
+		t.Fatalf("Enable returned error: %v", err)
+	}
+	if lastEnabled == nil || !*lastEnabled {
+		t.Errorf("Enable sent enabled = %v, want true", lastEnabled)
+	}
+
+	if _, err := c.Users.Disable(context.Background(), "alice"); err != nil {
+		t.Fatalf("Disable returned error: %v", err)
+	}
+	if lastEnabled == nil || *lastEnabled {
+		t.Errorf("Disable sent enabled = %v, want false", lastEnabled)
+	}
+}
+
+func TestUsersServiceAssignRoleAndListRoles(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/admin/users/alice/roles", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			var body assignRoleRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decoding body: %v", err)
+			}
+			if got, want := body.Rolename, "reader"; got != want {
+				t.Errorf("Rolename = %q, want %q", got, want)
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			w.Write([]byte(`{"roles":["reader"]}`))
+		}
+	})
+
+	c, closeFn := newTestClient(t, mux)
+	defer closeFn()
+
+	if _, err := c.Users.AssignRole(context.Background(), "alice", "reader"); err != nil {
+		t.Fatalf("AssignRole returned error: %v", err)
+	}
+
+	roles, _, err := c.Users.ListRoles(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("ListRoles returned error: %v", err)
+	}
+	if got, want := roles.Roles, []string{"reader"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Roles = %v, want %v", got, want)
+	}
+}